@@ -0,0 +1,130 @@
+package quaternion
+
+import "math"
+
+// DualQuaternion represents a rigid-body transform (a rotation composed with
+// a translation, i.e. an element of SE(3)) as a dual quaternion
+// Real + ε·Dual, where ε²=0. Unlike a Quaternion paired with a separate
+// Vec3, a DualQuaternion composes and interpolates rotation and translation
+// together with a single product or Sclerp call.
+type DualQuaternion struct {
+	Real Quaternion
+	Dual Quaternion
+}
+
+// NewDualQuat returns the DualQuaternion representing rotation rot followed
+// by translation trans.
+func NewDualQuat(rot Quaternion, trans Vec3) DualQuaternion {
+	return DualQuaternion{
+		Real: rot,
+		Dual: Prod(Scalar(0.5), Pure(trans.X, trans.Y, trans.Z), rot),
+	}
+}
+
+// Rotation returns the rotational part of dq.
+func (dq DualQuaternion) Rotation() Quaternion {
+	return dq.Real
+}
+
+// Translation returns the translation dq applies, recovered as the vector
+// part of 2·Dual·Real.Conj().
+func (dq DualQuaternion) Translation() Vec3 {
+	t := Prod(dq.Dual, dq.Real.Conj())
+	return Vec3{2 * t.X, 2 * t.Y, 2 * t.Z}
+}
+
+// Prod returns the non-commutative product of dq and other, composing the
+// two rigid transforms so that dq.Prod(other) applies other first.
+func (dq DualQuaternion) Prod(other DualQuaternion) DualQuaternion {
+	return DualQuaternion{
+		Real: Prod(dq.Real, other.Real),
+		Dual: Sum(Prod(dq.Real, other.Dual), Prod(dq.Dual, other.Real)),
+	}
+}
+
+// Conj returns the conjugate of dq, conjugating Real and Dual individually.
+// This is the conjugate used to invert a unit DualQuaternion's transform.
+func (dq DualQuaternion) Conj() DualQuaternion {
+	return DualQuaternion{Real: dq.Real.Conj(), Dual: dq.Dual.Conj()}
+}
+
+// Norm returns the dual-number norm of dq as (real, dual) such that
+// ||dq|| = real + ε·dual.
+func (dq DualQuaternion) Norm() (float64, float64) {
+	real := dq.Real.Norm()
+	dot := dq.Real.W*dq.Dual.W + dq.Real.X*dq.Dual.X + dq.Real.Y*dq.Dual.Y + dq.Real.Z*dq.Dual.Z
+	return real, dot / real
+}
+
+// Unit returns dq rescaled so that Real has unit norm and Dual is orthogonal
+// to it, the normalized form TransformVec3 and Sclerp expect.
+func (dq DualQuaternion) Unit() DualQuaternion {
+	k := dq.Real.Norm()
+	real := Quaternion{W: dq.Real.W / k, X: dq.Real.X / k, Y: dq.Real.Y / k, Z: dq.Real.Z / k}
+	dual := Quaternion{W: dq.Dual.W / k, X: dq.Dual.X / k, Y: dq.Dual.Y / k, Z: dq.Dual.Z / k}
+	dot := real.W*dual.W + real.X*dual.X + real.Y*dual.Y + real.Z*dual.Z
+	dual = Quaternion{
+		W: dual.W - dot*real.W,
+		X: dual.X - dot*real.X,
+		Y: dual.Y - dot*real.Y,
+		Z: dual.Z - dot*real.Z,
+	}
+	return DualQuaternion{Real: real, Dual: dual}
+}
+
+// TransformVec3 returns vec rotated and translated by dq.
+func (dq DualQuaternion) TransformVec3(vec Vec3) Vec3 {
+	rotated := dq.Real.RotateVec3(vec)
+	t := dq.Translation()
+	return Vec3{rotated.X + t.X, rotated.Y + t.Y, rotated.Z + t.Z}
+}
+
+// Sclerp returns the screw-linear interpolation between unit dual
+// quaternions a and b at t, interpolating the rigid transform between them
+// along the constant screw axis connecting them rather than blending their
+// components independently.
+func Sclerp(a, b DualQuaternion, t float64) DualQuaternion {
+	diff := a.Conj().Prod(b)
+	if diff.Real.W < 0 {
+		diff.Real = diff.Real.Neg()
+		diff.Dual = diff.Dual.Neg()
+	}
+
+	sinHalf := math.Sqrt(diff.Real.X*diff.Real.X + diff.Real.Y*diff.Real.Y + diff.Real.Z*diff.Real.Z)
+	cosHalf := diff.Real.W
+
+	var axis, moment Vec3
+	var theta, pitch float64
+	if sinHalf > 1e-9 {
+		theta = 2 * math.Atan2(sinHalf, cosHalf)
+		axis = Vec3{diff.Real.X / sinHalf, diff.Real.Y / sinHalf, diff.Real.Z / sinHalf}
+		pitch = -2 * diff.Dual.W / sinHalf
+		moment = Vec3{
+			X: (diff.Dual.X - axis.X*pitch*cosHalf/2) / sinHalf,
+			Y: (diff.Dual.Y - axis.Y*pitch*cosHalf/2) / sinHalf,
+			Z: (diff.Dual.Z - axis.Z*pitch*cosHalf/2) / sinHalf,
+		}
+	} else {
+		translationNorm := math.Sqrt(diff.Dual.X*diff.Dual.X + diff.Dual.Y*diff.Dual.Y + diff.Dual.Z*diff.Dual.Z)
+		pitch = 2 * translationNorm
+		if translationNorm > 1e-9 {
+			axis = Vec3{diff.Dual.X / translationNorm, diff.Dual.Y / translationNorm, diff.Dual.Z / translationNorm}
+		} else {
+			axis = Vec3{X: 1}
+		}
+	}
+
+	tTheta := t * theta
+	tPitch := t * pitch
+	s, c := math.Sin(tTheta/2), math.Cos(tTheta/2)
+	pow := DualQuaternion{
+		Real: Quaternion{W: c, X: s * axis.X, Y: s * axis.Y, Z: s * axis.Z},
+		Dual: Quaternion{
+			W: -tPitch / 2 * s,
+			X: s*moment.X + tPitch/2*c*axis.X,
+			Y: s*moment.Y + tPitch/2*c*axis.Y,
+			Z: s*moment.Z + tPitch/2*c*axis.Z,
+		},
+	}
+	return a.Prod(pow)
+}
@@ -0,0 +1,83 @@
+package quaternion
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDualQuatTransformVec3(t *testing.T) {
+	rot := q10
+	trans := Vec3{1, 2, 3}
+	dq := NewDualQuat(rot, trans)
+
+	p := Vec3{5, -1, 2}
+	got := dq.TransformVec3(p)
+
+	rotated := rot.RotateVec3(p)
+	want := Vec3{rotated.X + trans.X, rotated.Y + trans.Y, rotated.Z + trans.Z}
+
+	if math.Abs(got.X-want.X) > 1e-6 ||
+		math.Abs(got.Y-want.Y) > 1e-6 ||
+		math.Abs(got.Z-want.Z) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestDualQuatRotationTranslation(t *testing.T) {
+	rot := q7
+	trans := Vec3{-2, 0.5, 4}
+	dq := NewDualQuat(rot, trans)
+
+	if dq.Rotation() != rot {
+		t.Fail()
+	}
+
+	got := dq.Translation()
+	if math.Abs(got.X-trans.X) > 1e-6 ||
+		math.Abs(got.Y-trans.Y) > 1e-6 ||
+		math.Abs(got.Z-trans.Z) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestDualQuatProd(t *testing.T) {
+	a := NewDualQuat(q10, Vec3{1, 0, 0})
+	b := NewDualQuat(q9, Vec3{0, 1, 0})
+	c := a.Prod(b)
+
+	p := Vec3{1, 1, 1}
+	got := c.TransformVec3(p)
+	want := a.TransformVec3(b.TransformVec3(p))
+
+	if math.Abs(got.X-want.X) > 1e-6 ||
+		math.Abs(got.Y-want.Y) > 1e-6 ||
+		math.Abs(got.Z-want.Z) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestSclerpEndpoints(t *testing.T) {
+	a := NewDualQuat(qs1, Vec3{0, 0, 0})
+	b := NewDualQuat(q10, Vec3{2, 0, 0})
+
+	q0 := Sclerp(a, b, 0)
+	if math.Abs(q0.Real.W-a.Real.W) > 1e-6 || math.Abs(q0.Real.X-a.Real.X) > 1e-6 {
+		t.Fail()
+	}
+
+	q1 := Sclerp(a, b, 1)
+	if math.Abs(q1.Real.W-b.Real.W) > 1e-6 || math.Abs(q1.Real.X-b.Real.X) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestSclerpMidpointTranslation(t *testing.T) {
+	a := NewDualQuat(qs1, Vec3{0, 0, 0})
+	b := NewDualQuat(qs1, Vec3{2, 0, 0})
+
+	q := Sclerp(a, b, 0.5)
+	trans := q.Translation()
+	if math.Abs(trans.X-1) > 1e-6 || math.Abs(trans.Y) > 1e-6 || math.Abs(trans.Z) > 1e-6 {
+		t.Fail()
+	}
+}
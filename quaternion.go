@@ -116,6 +116,52 @@ func (qin Quaternion) RotateVec3(vec Vec3) Vec3 {
 	return Vec3{rot.X, rot.Y, rot.Z}
 }
 
+// RotateVec3s rotates each vector in src by q and writes the results to
+// dst, which must be at least as long as src (dst and src may alias). The
+// rotation matrix is computed once and applied as a tight per-vector loop,
+// avoiding the repeated quaternion products RotateVec3 performs for each
+// vector.
+func (q Quaternion) RotateVec3s(dst, src []Vec3) {
+	RotateVec3sInto(q, dst, src)
+}
+
+// RotateVec3sInto rotates each vector in src by q and writes the results to
+// dst (dst and src may alias).
+func RotateVec3sInto(q Quaternion, dst, src []Vec3) {
+	rotateVec3sWithMat(q.RotMat(), dst, src)
+}
+
+// RotateVec3sUnit is like RotateVec3s but assumes q is already unit length,
+// skipping the normalization RotateVec3s performs defensively.
+func (q Quaternion) RotateVec3sUnit(dst, src []Vec3) {
+	rotateVec3sWithMat(rotMatUnit(q), dst, src)
+}
+
+func rotateVec3sWithMat(m [3][3]float64, dst, src []Vec3) {
+	for i, v := range src {
+		dst[i] = Vec3{
+			X: m[0][0]*v.X + m[0][1]*v.Y + m[0][2]*v.Z,
+			Y: m[1][0]*v.X + m[1][1]*v.Y + m[1][2]*v.Z,
+			Z: m[2][0]*v.X + m[2][1]*v.Y + m[2][2]*v.Z,
+		}
+	}
+}
+
+// ProdMany writes dst[i] = Prod(a[i], b[i]) for each i, composing many
+// quaternion pairs without the variadic-slice overhead of calling Prod in a
+// loop. dst may alias a or b.
+func ProdMany(dst []Quaternion, a, b []Quaternion) {
+	for i := range a {
+		p, q := a[i], b[i]
+		dst[i] = Quaternion{
+			W: p.W*q.W - p.X*q.X - p.Y*q.Y - p.Z*q.Z,
+			X: p.W*q.X + p.X*q.W + p.Y*q.Z - p.Z*q.Y,
+			Y: p.W*q.Y + p.Y*q.W + p.Z*q.X - p.X*q.Z,
+			Z: p.W*q.Z + p.Z*q.W + p.X*q.Y - p.Y*q.X,
+		}
+	}
+}
+
 // Rotate returns the vector rotated by the quaternion.
 func (vin Vec3) Rotate(q Quaternion) Vec3 {
 	conj := q.Conj()
@@ -147,9 +193,126 @@ func FromEuler(phi, theta, psi float64) Quaternion {
 	return q
 }
 
+// EulerOrder identifies one of the twelve conventions for composing three
+// elemental axis rotations into a single orientation, following the axis
+// ordering used by glam's EulerRot. The six Tait-Bryan orders (XYZ, XZY,
+// YXZ, YZX, ZXY, ZYX) rotate about three distinct axes; the six proper
+// Euler orders (XYX, XZX, YXY, YZY, ZXZ, ZYZ) return to the first axis for
+// the third rotation.
+type EulerOrder int
+
+const (
+	OrderXYZ EulerOrder = iota
+	OrderXZY
+	OrderYXZ
+	OrderYZX
+	OrderZXY
+	OrderZYX
+	OrderXYX
+	OrderXZX
+	OrderYXY
+	OrderYZY
+	OrderZXZ
+	OrderZYZ
+)
+
+// eulerOrderInfo gives the axis indices (0=X, 1=Y, 2=Z) that Shoemake's
+// generic Euler-angle algorithm needs for a given order: i, j are the axes
+// of the first two rotations, h is the axis of the third (equal to i for
+// the repeating/proper-Euler orders and to k otherwise), k is the axis used
+// to resolve the extraction formulas, and parityOdd marks whether (i,j,k)
+// is an odd permutation of (X,Y,Z).
+type eulerOrderInfo struct {
+	i, j, k, h int
+	repeating  bool
+	parityOdd  bool
+}
+
+var eulerOrders = map[EulerOrder]eulerOrderInfo{
+	OrderXYZ: {i: 0, j: 1, k: 2, h: 2},
+	OrderXZY: {i: 0, j: 2, k: 1, h: 1, parityOdd: true},
+	OrderYXZ: {i: 1, j: 0, k: 2, h: 2, parityOdd: true},
+	OrderYZX: {i: 1, j: 2, k: 0, h: 0},
+	OrderZXY: {i: 2, j: 0, k: 1, h: 1},
+	OrderZYX: {i: 2, j: 1, k: 0, h: 0, parityOdd: true},
+	OrderXYX: {i: 0, j: 1, k: 2, h: 0, repeating: true},
+	OrderXZX: {i: 0, j: 2, k: 1, h: 0, repeating: true, parityOdd: true},
+	OrderYXY: {i: 1, j: 0, k: 2, h: 1, repeating: true, parityOdd: true},
+	OrderYZY: {i: 1, j: 2, k: 0, h: 1, repeating: true},
+	OrderZXZ: {i: 2, j: 0, k: 1, h: 2, repeating: true},
+	OrderZYZ: {i: 2, j: 1, k: 0, h: 2, repeating: true, parityOdd: true},
+}
+
+var eulerAxisVec = [3]Vec3{{X: 1}, {Y: 1}, {Z: 1}}
+
+// FromEulerOrder returns the Quaternion produced by rotating a about order's
+// first axis, then b about its second axis, then c about its third axis,
+// each applied to the frame resulting from the previous rotation.
+func FromEulerOrder(order EulerOrder, a, b, c float64) Quaternion {
+	info := eulerOrders[order]
+	qa := FromAxisAngle(eulerAxisVec[info.i], a)
+	qb := FromAxisAngle(eulerAxisVec[info.j], b)
+	qc := FromAxisAngle(eulerAxisVec[info.h], c)
+	return Prod(qa, qb, qc)
+}
+
+// EulerOrder returns the angles a, b, c such that
+// FromEulerOrder(order, a, b, c) reproduces q, using the axis convention
+// order describes. Near a gimbal-lock configuration (the second angle at
+// the boundary of its range) the third angle is set to zero and the
+// remaining two are solved from the degenerate matrix entries.
+func (qin Quaternion) EulerOrder(order EulerOrder) (float64, float64, float64) {
+	info := eulerOrders[order]
+	i, j, k := info.i, info.j, info.k
+	m := qin.RotMat()
+
+	const eps = 1e-9
+	var a, b, c float64
+	if info.repeating {
+		sy := math.Sqrt(m[i][j]*m[i][j] + m[i][k]*m[i][k])
+		if sy > eps {
+			a = math.Atan2(m[j][i], -m[k][i])
+			b = math.Atan2(sy, m[i][i])
+			c = math.Atan2(m[i][j], m[i][k])
+		} else {
+			sign := 1.0
+			if m[i][i] < 0 {
+				sign = -1.0
+			}
+			a = math.Atan2(-sign*m[j][k], m[j][j])
+			b = math.Atan2(sy, m[i][i])
+			c = 0
+		}
+	} else {
+		cy := math.Sqrt(m[i][i]*m[i][i] + m[i][j]*m[i][j])
+		if cy > eps {
+			a = math.Atan2(-m[j][k], m[k][k])
+			b = math.Atan2(m[i][k], cy)
+			c = math.Atan2(-m[i][j], m[i][i])
+		} else {
+			sign := 1.0
+			if m[i][k] < 0 {
+				sign = -1.0
+			}
+			a = math.Atan2(sign*m[j][i], m[j][j])
+			b = math.Atan2(m[i][k], cy)
+			c = 0
+		}
+	}
+	if info.parityOdd {
+		a, b, c = -a, -b, -c
+	}
+	return a, b, c
+}
+
 // RotMat returns the rotation matrix (as float array) corresponding to a Quaternion
 func (qin Quaternion) RotMat() [3][3]float64 {
-	q := qin.Unit()
+	return rotMatUnit(qin.Unit())
+}
+
+// rotMatUnit returns the rotation matrix for q, assuming q is already unit
+// length.
+func rotMatUnit(q Quaternion) [3][3]float64 {
 	m := [3][3]float64{}
 	m[0][0] = 1 - 2*(q.Y*q.Y+q.Z*q.Z)
 	m[0][1] = 2 * (q.X*q.Y - q.W*q.Z)
@@ -165,6 +328,69 @@ func (qin Quaternion) RotMat() [3][3]float64 {
 	return m
 }
 
+// FromRotMat returns the Quaternion corresponding to the rotation matrix m,
+// using Shepperd's method to pick whichever of the four algebraically
+// equivalent formulas keeps the division well away from zero.
+func FromRotMat(m [3][3]float64) Quaternion {
+	tr := m[0][0] + m[1][1] + m[2][2]
+	switch {
+	case tr > 0:
+		s := 2 * math.Sqrt(tr+1)
+		return Quaternion{
+			W: s / 4,
+			X: (m[2][1] - m[1][2]) / s,
+			Y: (m[0][2] - m[2][0]) / s,
+			Z: (m[1][0] - m[0][1]) / s,
+		}
+	case m[0][0] > m[1][1] && m[0][0] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[0][0]-m[1][1]-m[2][2])
+		return Quaternion{
+			W: (m[2][1] - m[1][2]) / s,
+			X: s / 4,
+			Y: (m[0][1] + m[1][0]) / s,
+			Z: (m[0][2] + m[2][0]) / s,
+		}
+	case m[1][1] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[1][1]-m[0][0]-m[2][2])
+		return Quaternion{
+			W: (m[0][2] - m[2][0]) / s,
+			X: (m[0][1] + m[1][0]) / s,
+			Y: s / 4,
+			Z: (m[1][2] + m[2][1]) / s,
+		}
+	default:
+		s := 2 * math.Sqrt(1+m[2][2]-m[0][0]-m[1][1])
+		return Quaternion{
+			W: (m[1][0] - m[0][1]) / s,
+			X: (m[0][2] + m[2][0]) / s,
+			Y: (m[1][2] + m[2][1]) / s,
+			Z: s / 4,
+		}
+	}
+}
+
+// FromAxisAngle returns the Quaternion representing a rotation of angle
+// radians about axis.
+func FromAxisAngle(axis Vec3, angle float64) Quaternion {
+	axis = axis.Normalize()
+	s := math.Sin(angle / 2)
+	return Quaternion{W: math.Cos(angle / 2), X: axis.X * s, Y: axis.Y * s, Z: axis.Z * s}
+}
+
+// AxisAngle returns the axis and angle (in radians) of the rotation qin
+// represents. It returns Vec3{1,0,0} for the axis when qin is the identity,
+// since there is then no meaningful axis to report.
+func (qin Quaternion) AxisAngle() (Vec3, float64) {
+	q := qin.Unit()
+	v := Vec3{q.X, q.Y, q.Z}
+	n := v.Length()
+	angle := 2 * math.Atan2(n, q.W)
+	if n < 1e-12 {
+		return Vec3{X: 1}, angle
+	}
+	return Vec3{v.X / n, v.Y / n, v.Z / n}, angle
+}
+
 func (a Vec3) Normalize() Vec3 {
 	r := 1 / math.Sqrt(float64(a.X*a.X+a.Y*a.Y+a.Z*a.Z))
 	return Vec3{a.X * r, a.Y * r, a.Z * r}
@@ -232,3 +458,115 @@ func From2Vecs(v1, v2 Vec3) Quaternion {
 
 	return q
 }
+
+// LookRotation returns the Quaternion that orients an object so that it
+// faces forward with the given up direction, the standard camera/aircraft
+// "look at" construction. It builds an orthonormal basis from forward and
+// up and delegates to FromRotMat. If forward and up are parallel, an
+// arbitrary perpendicular axis is substituted for up, mirroring the
+// fallback From2Vecs uses for parallel inputs.
+func LookRotation(forward, up Vec3) Quaternion {
+	f := forward.Normalize()
+
+	r := up.Cross(f)
+	if r.Length() < 1e-6 {
+		xUnitVec := Vec3{X: 1}
+		r = xUnitVec.Cross(f)
+		if r.Length() < 1e-6 {
+			r = Vec3{Y: 1}.Cross(f)
+		}
+	}
+	r = r.Normalize()
+	u := f.Cross(r)
+
+	m := [3][3]float64{
+		{r.X, u.X, f.X},
+		{r.Y, u.Y, f.Y},
+		{r.Z, u.Z, f.Z},
+	}
+	return FromRotMat(m)
+}
+
+// Slerp returns the spherical linear interpolation between q1 and q2 at t,
+// taking the short arc between them. Inputs need not be unit quaternions;
+// they are normalized internally. When q1 and q2 are nearly colinear the
+// result falls back to Nlerp to avoid dividing by a near-zero sine.
+func Slerp(q1, q2 Quaternion, t float64) Quaternion {
+	q1 = q1.Unit()
+	q2 = q2.Unit()
+
+	cosOmega := q1.W*q2.W + q1.X*q2.X + q1.Y*q2.Y + q1.Z*q2.Z
+	if cosOmega < 0 {
+		q2 = q2.Neg()
+		cosOmega = -cosOmega
+	}
+
+	if cosOmega > 1-1e-6 {
+		return Nlerp(q1, q2, t)
+	}
+
+	omega := math.Acos(cosOmega)
+	sinOmega := math.Sin(omega)
+	s1 := math.Sin((1-t)*omega) / sinOmega
+	s2 := math.Sin(t*omega) / sinOmega
+	return Quaternion{
+		W: s1*q1.W + s2*q2.W,
+		X: s1*q1.X + s2*q2.X,
+		Y: s1*q1.Y + s2*q2.Y,
+		Z: s1*q1.Z + s2*q2.Z,
+	}
+}
+
+// Nlerp returns the normalized linear interpolation between q1 and q2 at t,
+// taking the short arc the same way Slerp does. It is cheaper than Slerp and
+// a good approximation for it when q1 and q2 are close together.
+func Nlerp(q1, q2 Quaternion, t float64) Quaternion {
+	q1 = q1.Unit()
+	q2 = q2.Unit()
+
+	cosOmega := q1.W*q2.W + q1.X*q2.X + q1.Y*q2.Y + q1.Z*q2.Z
+	if cosOmega < 0 {
+		q2 = q2.Neg()
+	}
+
+	return Quaternion{
+		W: (1-t)*q1.W + t*q2.W,
+		X: (1-t)*q1.X + t*q2.X,
+		Y: (1-t)*q1.Y + t*q2.Y,
+		Z: (1-t)*q1.Z + t*q2.Z,
+	}.Unit()
+}
+
+// Log returns the quaternion logarithm of the unit quaternion qin, a pure
+// quaternion whose vector part is the rotation axis scaled by half the
+// rotation angle.
+func (qin Quaternion) Log() Quaternion {
+	q := qin.Unit()
+	theta := math.Sqrt(q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	if theta < 1e-12 {
+		return Quaternion{0, q.X, q.Y, q.Z}
+	}
+	k := math.Atan2(theta, q.W) / theta
+	return Quaternion{0, q.X * k, q.Y * k, q.Z * k}
+}
+
+// Exp returns the quaternion exponential of qin, inverting Log for pure
+// quaternions and so giving back the unit quaternion for the rotation they encode.
+func (qin Quaternion) Exp() Quaternion {
+	theta := math.Sqrt(qin.X*qin.X + qin.Y*qin.Y + qin.Z*qin.Z)
+	ew := math.Exp(qin.W)
+	if theta < 1e-12 {
+		return Quaternion{ew, 0, 0, 0}
+	}
+	k := ew * math.Sin(theta) / theta
+	return Quaternion{ew * math.Cos(theta), qin.X * k, qin.Y * k, qin.Z * k}
+}
+
+// Pow returns qin raised to the power t, the rotation qin represents scaled by
+// t along the same axis: Pow(0) is the identity, Pow(1) returns qin.Unit(),
+// and Pow(0.5) is the square root of the rotation. Like Log, it treats qin as
+// a unit quaternion, so the result is always unit length.
+func (qin Quaternion) Pow(t float64) Quaternion {
+	l := qin.Log()
+	return Quaternion{l.W * t, l.X * t, l.Y * t, l.Z * t}.Exp()
+}
@@ -186,3 +186,238 @@ func TestFrom2Vecs(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestSlerpEndpoints(t *testing.T) {
+	q0 := Slerp(qs1, q10, 0)
+	q1 := Slerp(qs1, q10, 1)
+	if math.Abs(q0.W-qs1.W) > 1e-6 || math.Abs(q0.X-qs1.X) > 1e-6 {
+		t.Fail()
+	}
+	if math.Abs(q1.W-q10.W) > 1e-6 || math.Abs(q1.X-q10.X) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestSlerpHalfway(t *testing.T) {
+	q := Slerp(qs1, q10, 0.5)
+	want := Quaternion{0.9238795325112867, 0.3826834323650898, 0, 0}
+	if math.Abs(q.W-want.W) > 1e-6 ||
+		math.Abs(q.X-want.X) > 1e-6 ||
+		math.Abs(q.Y-want.Y) > 1e-6 ||
+		math.Abs(q.Z-want.Z) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestSlerpAntipodal(t *testing.T) {
+	q := Slerp(qs1, qs1.Neg(), 0.5)
+	if math.Abs(q.Norm()-1) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestNlerpHalfway(t *testing.T) {
+	q := Nlerp(qs1, q10, 0.5)
+	if math.Abs(q.Norm()-1) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestLogIdentity(t *testing.T) {
+	if qs1.Log() != (Quaternion{0, 0, 0, 0}) {
+		t.Fail()
+	}
+}
+
+func TestLogExpRoundTrip(t *testing.T) {
+	q := q10.Log().Exp()
+	if math.Abs(q.W-q10.W) > 1e-6 ||
+		math.Abs(q.X-q10.X) > 1e-6 ||
+		math.Abs(q.Y-q10.Y) > 1e-6 ||
+		math.Abs(q.Z-q10.Z) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestPowIdentity(t *testing.T) {
+	q := q10.Pow(0)
+	if math.Abs(q.W-1) > 1e-6 || math.Abs(q.X) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestPowOne(t *testing.T) {
+	q := q10.Pow(1)
+	if math.Abs(q.W-q10.W) > 1e-6 || math.Abs(q.X-q10.X) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestFromEulerOrderSingleAxis(t *testing.T) {
+	q := FromEulerOrder(OrderXYZ, math.Pi/2, 0, 0)
+	if math.Abs(q.W-q10.W) > 1e-6 || math.Abs(q.X-q10.X) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestFromRotMatRoundTrip(t *testing.T) {
+	qs := []Quaternion{qs1, q7, q8, q9, q10}
+	for angle := -3.0; angle <= 3.0; angle += 0.5 {
+		qs = append(qs, FromAxisAngle(Vec3{1, 2, 3}, angle))
+	}
+	for _, q := range qs {
+		q = q.Unit()
+		got := FromRotMat(q.RotMat())
+		dot := got.W*q.W + got.X*q.X + got.Y*q.Y + got.Z*q.Z
+		if math.Abs(math.Abs(dot)-1) > 1e-6 {
+			t.Errorf("FromRotMat(q.RotMat()) did not reproduce q=%v, got %v", q, got)
+		}
+	}
+}
+
+func TestFromAxisAngle(t *testing.T) {
+	q := FromAxisAngle(Vec3{X: 1}, math.Pi/2)
+	if math.Abs(q.W-q10.W) > 1e-6 || math.Abs(q.X-q10.X) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestAxisAngleRoundTrip(t *testing.T) {
+	axis, angle := q7.AxisAngle()
+	q := FromAxisAngle(axis, angle)
+	dot := q.W*q7.W + q.X*q7.X + q.Y*q7.Y + q.Z*q7.Z
+	if math.Abs(dot-1) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestAxisAngleIdentity(t *testing.T) {
+	axis, angle := qs1.AxisAngle()
+	if axis != (Vec3{1, 0, 0}) || math.Abs(angle) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestRotateVec3sMatchesRotateVec3(t *testing.T) {
+	src := []Vec3{v1, v2, {1, 2, 3}}
+	dst := make([]Vec3, len(src))
+	q7.RotateVec3s(dst, src)
+	for i, v := range src {
+		want := q7.RotateVec3(v)
+		if math.Abs(dst[i].X-want.X) > 1e-9 ||
+			math.Abs(dst[i].Y-want.Y) > 1e-9 ||
+			math.Abs(dst[i].Z-want.Z) > 1e-9 {
+			t.Fail()
+		}
+	}
+}
+
+func TestRotateVec3sAliasing(t *testing.T) {
+	src := []Vec3{v1, v2, {1, 2, 3}}
+	want := make([]Vec3, len(src))
+	q7.RotateVec3s(want, src)
+
+	vecs := []Vec3{v1, v2, {1, 2, 3}}
+	q7.RotateVec3s(vecs, vecs)
+	for i := range vecs {
+		if math.Abs(vecs[i].X-want[i].X) > 1e-9 ||
+			math.Abs(vecs[i].Y-want[i].Y) > 1e-9 ||
+			math.Abs(vecs[i].Z-want[i].Z) > 1e-9 {
+			t.Fail()
+		}
+	}
+}
+
+func TestRotateVec3sUnit(t *testing.T) {
+	q := q7.Unit()
+	src := []Vec3{v1, v2}
+	dst := make([]Vec3, len(src))
+	q.RotateVec3sUnit(dst, src)
+	for i, v := range src {
+		want := q.RotateVec3(v)
+		if math.Abs(dst[i].X-want.X) > 1e-9 ||
+			math.Abs(dst[i].Y-want.Y) > 1e-9 ||
+			math.Abs(dst[i].Z-want.Z) > 1e-9 {
+			t.Fail()
+		}
+	}
+}
+
+func TestProdMany(t *testing.T) {
+	a := []Quaternion{q1, q7}
+	b := []Quaternion{q2, q9}
+	dst := make([]Quaternion, len(a))
+	ProdMany(dst, a, b)
+	for i := range a {
+		if dst[i] != Prod(a[i], b[i]) {
+			t.Fail()
+		}
+	}
+}
+
+func BenchmarkRotateVec3(b *testing.B) {
+	vecs := make([]Vec3, 1000)
+	for i := range vecs {
+		vecs[i] = Vec3{X: float64(i), Y: float64(i) * 2, Z: float64(i) * 3}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, v := range vecs {
+			vecs[j] = q7.RotateVec3(v)
+		}
+	}
+}
+
+func BenchmarkRotateVec3s(b *testing.B) {
+	vecs := make([]Vec3, 1000)
+	for i := range vecs {
+		vecs[i] = Vec3{X: float64(i), Y: float64(i) * 2, Z: float64(i) * 3}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q7.RotateVec3s(vecs, vecs)
+	}
+}
+
+func TestLookRotationIdentity(t *testing.T) {
+	q := LookRotation(Vec3{0, 0, 1}, Vec3{0, 1, 0})
+	if math.Abs(q.W-1) > 1e-6 || math.Abs(q.X) > 1e-6 ||
+		math.Abs(q.Y) > 1e-6 || math.Abs(q.Z) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestLookRotationFacesForward(t *testing.T) {
+	forward := Vec3{1, 2, 3}.Normalize()
+	up := Vec3{0, 1, 0}
+	q := LookRotation(forward, up)
+	got := q.RotateVec3(Vec3{0, 0, 1})
+	if math.Abs(got.X-forward.X) > 1e-6 ||
+		math.Abs(got.Y-forward.Y) > 1e-6 ||
+		math.Abs(got.Z-forward.Z) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestLookRotationParallelFallback(t *testing.T) {
+	q := LookRotation(Vec3{0, 1, 0}, Vec3{0, 1, 0})
+	if math.Abs(q.Norm()-1) > 1e-6 {
+		t.Fail()
+	}
+}
+
+func TestEulerOrderRoundTrip(t *testing.T) {
+	orders := []EulerOrder{OrderXYZ, OrderXZY, OrderYXZ, OrderYZX, OrderZXY, OrderZYX,
+		OrderXYX, OrderXZX, OrderYXY, OrderYZY, OrderZXZ, OrderZYZ}
+	qs := []Quaternion{q7, q8, q9, q10}
+	for _, order := range orders {
+		for _, qin := range qs {
+			a, b, c := qin.EulerOrder(order)
+			q := FromEulerOrder(order, a, b, c)
+			dot := q.W*qin.W + q.X*qin.X + q.Y*qin.Y + q.Z*qin.Z
+			if math.Abs(math.Abs(dot)-1) > 1e-6 {
+				t.Errorf("order %v: FromEulerOrder(q.EulerOrder()) did not reproduce q=%v, got %v", order, qin, q)
+			}
+		}
+	}
+}